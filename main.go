@@ -10,7 +10,9 @@ import (
 	"time"
 
 	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/spinner"
 	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -24,11 +26,102 @@ const (
 	stepBackupSelection
 	stepBackupName
 	stepExecute
-	helpMessage        = "Press Enter to confirm or 'ctrl+c' to quit"
-	errorMessageFormat = "\nError: %v\n"
-	viewFormat         = "\n\n%s\n%s"
+	stepResult
+	stepScheduleCron
+	stepScheduleTTL
+	stepScheduleName
+	stepScheduleList
+	stepProgress
+	stepBackupOptions
+	stepRestoreOptions
+	helpMessage         = "Press Enter to confirm or 'ctrl+c' to quit"
+	resultHelpMessage   = "Press 'l' to view logs, 'ctrl+c' to quit"
+	scheduleListHelp    = "Press 'p' to pause/unpause, 'd' to delete, 'ctrl+c' to quit"
+	progressHelpMessage = "Use ↑/↓ to scroll the log · 'ctrl+c' to quit"
+	backupOptionsHelp   = "Tab: next field · Space: toggle · Enter: continue · 'ctrl+c' to quit"
+	restoreOptionsHelp  = "Tab: next field · Space: toggle · Enter: preview & continue · 'ctrl+c' to quit"
+	errorMessageFormat  = "\nError: %v\n"
+	viewFormat          = "\n\n%s\n%s"
+
+	pollInterval = 5 * time.Second
+)
+
+// backupOptionsField identifies which field of the backup options form is
+// currently focused; Tab cycles through them in order.
+type backupOptionsField int
+
+const (
+	fieldTTL backupOptionsField = iota
+	fieldSnapshotVolumes
+	fieldIncludeClusterResources
+	fieldSelector
+	fieldExcludeNamespaces
+	numBackupOptionsFields
+)
+
+// restoreOptionsField identifies which field of the restore options form is
+// currently focused; Tab cycles through them in order.
+type restoreOptionsField int
+
+const (
+	restoreFieldNamespaceMappings restoreOptionsField = iota
+	restoreFieldIncludeNamespaces
+	restoreFieldExcludeNamespaces
+	restoreFieldRestoreVolumes
+	restoreFieldPreserveNodeports
+	numRestoreOptionsFields
 )
 
+// focusableField lets a tab-cycled form field react to gaining or losing
+// focus. Fields whose keystrokes are routed by the Update loop itself
+// rather than by textinput-style focus state (toggles, lists) use
+// noopFocusField.
+type focusableField interface {
+	Focus() tea.Cmd
+	Blur()
+}
+
+type noopFocusField struct{}
+
+func (noopFocusField) Focus() tea.Cmd { return nil }
+func (noopFocusField) Blur()          {}
+
+// tabForm focuses whichever field of a fixed, ordered field list sits at a
+// given index and blurs the rest, so Tab-cycling a form only routes
+// keystrokes to one field at a time regardless of how many of its fields
+// are plain toggles or lists rather than text inputs.
+type tabForm struct {
+	fields []focusableField
+}
+
+func (f tabForm) focusField(index int) tea.Cmd {
+	var cmd tea.Cmd
+	for i, field := range f.fields {
+		if i == index {
+			cmd = field.Focus()
+			continue
+		}
+		field.Blur()
+	}
+	return cmd
+}
+
+// cycleFocus advances (delta=1) or retreats (delta=-1) a focus index
+// within a form of the given field count, wrapping around in both
+// directions.
+func cycleFocus(current, count, delta int) int {
+	return ((current+delta)%count + count) % count
+}
+
+// focusMarker renders the "> " cursor used to mark whichever field of a
+// form currently has Tab focus.
+func focusMarker(focused bool) string {
+	if focused {
+		return "> "
+	}
+	return "  "
+}
+
 type item struct {
 	title       string
 	description string
@@ -38,27 +131,107 @@ func (i item) Title() string       { return i.title }
 func (i item) Description() string { return i.description }
 func (i item) FilterValue() string { return i.title }
 
+// veleroStatus mirrors the subset of a Backup/Restore's status block that the
+// TUI needs to report on once a run reaches a terminal phase.
+type veleroStatus struct {
+	Status struct {
+		Phase                    string `json:"phase"`
+		Warnings                 int    `json:"warnings"`
+		Errors                   int    `json:"errors"`
+		VolumeSnapshotsAttempted int    `json:"volumeSnapshotsAttempted"`
+		VolumeSnapshotsCompleted int    `json:"volumeSnapshotsCompleted"`
+		Progress                 struct {
+			ItemsBackedUp int `json:"itemsBackedUp"`
+			TotalItems    int `json:"totalItems"`
+		} `json:"progress"`
+	} `json:"status"`
+}
+
+// backupStatusMsg reports an in-progress phase observed while polling; the
+// TUI stays on stepProgress and schedules another poll.
+type backupStatusMsg struct {
+	operation string
+	name      string
+	status    veleroStatus
+}
+
+// backupCompleteMsg reports that the operation reached a terminal phase
+// (Completed, PartiallyFailed, or Failed); the TUI moves on to stepResult.
+type backupCompleteMsg struct {
+	operation string
+	name      string
+	status    veleroStatus
+}
+
+// backupFailedMsg reports that polling itself failed (command or parse
+// error), as opposed to the operation finishing with a Failed phase.
+type backupFailedMsg struct {
+	operation string
+	name      string
+	err       error
+}
+
 type model struct {
-	contextList     list.Model
-	namespaceList   list.Model
-	backupList      list.Model
-	operationList   list.Model
-	err             error
-	selectedOp      item
-	selectedCtx     item
-	selectedBackup  item
-	backupName      string
-	selectedNS      []list.Item
-	backupNameInput textinput.Model
-	step            step
+	contextList       list.Model
+	namespaceList     list.Model
+	backupList        list.Model
+	operationList     list.Model
+	scheduleList      list.Model
+	err               error
+	selectedOp        item
+	selectedCtx       item
+	selectedBackup    item
+	backupName        string
+	selectedNS        []list.Item
+	backupNameInput   textinput.Model
+	cronInput         textinput.Model
+	ttlInput          textinput.Model
+	scheduleNameInput textinput.Model
+	scheduleCron      string
+	scheduleTTL       string
+	scheduleName      string
+	step              step
+	progressOperation string
+	progressName      string
+	progressStatus    veleroStatus
+	progressLog       []string
+	progressSpinner   spinner.Model
+	progressViewport  viewport.Model
+	resultOperation   string
+	resultName        string
+	resultStatus      *veleroStatus
+	resultMessage     string
+	resultLogs        string
+
+	backupOptionsFocus      backupOptionsField
+	backupTTLInput          textinput.Model
+	backupSnapshotVolumes   bool
+	backupIncludeClusterRes *bool
+	backupSelectorInput     textinput.Model
+	excludeNamespaceList    list.Model
+	selectedExcludeNS       []list.Item
+
+	restoreOptionsFocus      restoreOptionsField
+	namespaceMappingsInput   textinput.Model
+	restoreIncludeNSList     list.Model
+	selectedRestoreIncludeNS []list.Item
+	restoreExcludeNSList     list.Model
+	selectedRestoreExcludeNS []list.Item
+	restoreVolumes           bool
+	preserveNodeports        bool
 }
 
 var (
 	helpStyle         = lipgloss.NewStyle().Foreground(lipgloss.Color("241")).Render
 	titleStyle        = lipgloss.NewStyle().MarginLeft(2).Bold(true).Render
 	errorStyle        = lipgloss.NewStyle().Foreground(lipgloss.Color("9")).Bold(true).Render
+	warningStyle      = lipgloss.NewStyle().Foreground(lipgloss.Color("11")).Bold(true).Render
+	successStyle      = lipgloss.NewStyle().Foreground(lipgloss.Color("10")).Bold(true).Render
 	listStyle         = lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).Padding(0, 1).Margin(1).Width(70).Height(20)
 	selectedListStyle = lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).Padding(0, 1).Margin(1).Width(50).Height(20).Foreground(lipgloss.Color("205"))
+	resultStyle       = lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).Padding(1, 2).Margin(1).Width(70)
+	progressStyle     = lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).Padding(1, 2).Margin(1).Width(70)
+	spinnerStyle      = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
 	logFile           *os.File
 	logger            *log.Logger
 )
@@ -73,6 +246,15 @@ func init() {
 	logger = log.New(logFile, "VELERO-UI: ", log.Ldate|log.Ltime|log.Lshortfile)
 }
 
+// shellQuote single-quotes a value for safe interpolation into the command
+// strings runShellCommand hands to `sh -c`, escaping any embedded single
+// quotes. Used for free-text fields (label selectors, namespace mappings)
+// that would otherwise let shell metacharacters like `$()` or backticks
+// reach the shell.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
 func runShellCommand(cmdStr string, logOutput bool) (string, error) {
 	logger.Printf("Running command: %s", cmdStr)
 	cmd := exec.Command("sh", "-c", cmdStr)
@@ -123,30 +305,132 @@ func fetchBackups() ([]list.Item, error) {
 	return items, nil
 }
 
-func waitForCompletion(operation, name string) error {
-	for {
+func fetchSchedules() ([]list.Item, error) {
+	output, err := runShellCommand("velero schedule get -o json", false)
+	if err != nil {
+		return nil, err
+	}
+
+	var schedules []struct {
+		Metadata struct {
+			Name string `json:"name"`
+		} `json:"metadata"`
+		Spec struct {
+			Schedule string `json:"schedule"`
+			Paused   bool   `json:"paused"`
+		} `json:"spec"`
+	}
+
+	if err := json.Unmarshal([]byte(output), &schedules); err != nil {
+		return nil, err
+	}
+
+	items := make([]list.Item, len(schedules))
+	for i, schedule := range schedules {
+		status := "Active"
+		if schedule.Spec.Paused {
+			status = "Paused"
+		}
+		items[i] = item{title: schedule.Metadata.Name, description: fmt.Sprintf("%s (%s)", schedule.Spec.Schedule, status)}
+	}
+
+	return items, nil
+}
+
+// fetchBackupNamespaces inspects a backup's contents via `velero backup
+// describe --details` and returns the namespaces it included, so the restore
+// wizard can offer them as include/exclude candidates instead of the full
+// cluster namespace list. When the backup's "Included:" line is "*",
+// "<none>" or missing, it falls back to the live namespace list; the
+// "Excluded:" line is not read.
+func fetchBackupNamespaces(backupName string) ([]list.Item, error) {
+	output, err := runShellCommand(fmt.Sprintf("velero backup describe %s --details", backupName), false)
+	if err != nil {
+		return nil, err
+	}
+
+	var included string
+	lines := strings.Split(output, "\n")
+	for i, line := range lines {
+		if strings.TrimSpace(line) != "Namespaces:" {
+			continue
+		}
+		for _, nsLine := range lines[i+1:] {
+			trimmed := strings.TrimSpace(nsLine)
+			if trimmed == "" {
+				break
+			}
+			if strings.HasPrefix(trimmed, "Included:") {
+				included = strings.TrimSpace(strings.TrimPrefix(trimmed, "Included:"))
+			}
+			if strings.HasPrefix(trimmed, "Excluded:") {
+				break
+			}
+		}
+		break
+	}
+
+	if included == "" || included == "<none>" || included == "*" {
+		return fetchItems("kubectl get namespaces -o custom-columns=NAME:.metadata.name --no-headers")
+	}
+
+	names := strings.Split(included, ",")
+	items := make([]list.Item, 0, len(names))
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name != "" {
+			items = append(items, item{title: name, description: ""})
+		}
+	}
+	return items, nil
+}
+
+// pollOperation fetches the operation's status once, after the given delay,
+// and reports it back as a tea.Msg instead of blocking the UI goroutine.
+// On a terminal phase (Completed, PartiallyFailed, or Failed) it reports
+// backupCompleteMsg; otherwise backupStatusMsg, which the Update loop uses
+// to schedule the next poll.
+func pollOperation(operation, name string, delay time.Duration) tea.Cmd {
+	return tea.Tick(delay, func(time.Time) tea.Msg {
 		statusCmd := fmt.Sprintf("velero %s describe %s --details -o json", operation, name)
 		output, err := runShellCommand(statusCmd, false)
 		if err != nil {
 			logger.Printf("Error fetching %s status: %v", operation, err)
-			return err
+			return backupFailedMsg{operation: operation, name: name, err: err}
 		}
-		if strings.Contains(output, "\"Phase\": \"Completed\"") {
-			logger.Printf("%s %s completed successfully", operation, name)
-			return nil
+
+		var status veleroStatus
+		if err := json.Unmarshal([]byte(output), &status); err != nil {
+			logger.Printf("Error parsing %s status: %v", operation, err)
+			return backupFailedMsg{operation: operation, name: name, err: err}
 		}
-		if strings.Contains(output, "\"Phase\": \"Failed\"") {
-			logger.Printf("%s %s failed", operation, name)
-			return fmt.Errorf("%s %s failed", operation, name)
+
+		switch status.Status.Phase {
+		case "Completed", "PartiallyFailed", "Failed":
+			logger.Printf("%s %s finished with phase %s", operation, name, status.Status.Phase)
+			return backupCompleteMsg{operation: operation, name: name, status: status}
+		default:
+			return backupStatusMsg{operation: operation, name: name, status: status}
 		}
-		time.Sleep(5 * time.Second)
-	}
+	})
+}
+
+// formatProgressLine renders one line of the scrolling progress log shown
+// alongside the pinned status header in stepProgress.
+func formatProgressLine(status veleroStatus) string {
+	s := status.Status
+	return fmt.Sprintf(
+		"[%s] phase=%s items=%d/%d warnings=%d errors=%d",
+		time.Now().Format("15:04:05"), s.Phase, s.Progress.ItemsBackedUp, s.Progress.TotalItems, s.Warnings, s.Errors,
+	)
 }
 
 func initialModel() model {
 	operations := []list.Item{
 		item{title: "Backup", description: "Create a velero backup"},
 		item{title: "Restore", description: "Restore a velero backup"},
+		item{title: "Schedule", description: "Create a recurring backup schedule"},
+		item{title: "Manage Schedules", description: "Pause, unpause, or delete existing schedules"},
 	}
 
 	delegate := list.NewDefaultDelegate()
@@ -178,18 +462,93 @@ func initialModel() model {
 	backupList.SetShowHelp(false)
 	backupList.SetSize(70, 20)
 
+	scheduleList := list.New([]list.Item{}, delegate, 0, 0)
+	scheduleList.Title = titleStyle("Manage Schedules (p: pause/unpause, d: delete)")
+	scheduleList.SetShowStatusBar(false)
+	scheduleList.SetFilteringEnabled(false)
+	scheduleList.SetShowHelp(false)
+	scheduleList.SetSize(70, 20)
+
+	excludeNamespaceList := list.New([]list.Item{}, delegate, 0, 0)
+	excludeNamespaceList.Title = titleStyle("Exclude Namespaces (space to toggle)")
+	excludeNamespaceList.SetShowStatusBar(false)
+	excludeNamespaceList.SetFilteringEnabled(false)
+	excludeNamespaceList.SetShowHelp(false)
+	excludeNamespaceList.SetSize(70, 20)
+
 	backupNameInput := textinput.New()
 	backupNameInput.Placeholder = "Enter backup name"
 	backupNameInput.Width = 70
 
+	cronInput := textinput.New()
+	cronInput.Placeholder = "Enter cron schedule, e.g. 0 2 * * *"
+	cronInput.Width = 70
+
+	ttlInput := textinput.New()
+	ttlInput.Placeholder = "Enter TTL, e.g. 720h0m0s"
+	ttlInput.Width = 70
+
+	scheduleNameInput := textinput.New()
+	scheduleNameInput.Placeholder = "Enter schedule name"
+	scheduleNameInput.Width = 70
+
+	progressSpinner := spinner.New()
+	progressSpinner.Spinner = spinner.Dot
+	progressSpinner.Style = spinnerStyle
+
+	progressViewport := viewport.New(70, 10)
+
+	backupTTLInput := textinput.New()
+	backupTTLInput.Placeholder = "e.g. 720h0m0s (empty uses the server default)"
+	backupTTLInput.Width = 70
+
+	backupSelectorInput := textinput.New()
+	backupSelectorInput.Placeholder = "e.g. app=nginx,env=prod"
+	backupSelectorInput.Width = 70
+
+	namespaceMappingsInput := textinput.New()
+	namespaceMappingsInput.Placeholder = "e.g. old1:new1,old2:new2 (empty restores as-is)"
+	namespaceMappingsInput.Width = 70
+
+	restoreIncludeNSList := list.New([]list.Item{}, delegate, 0, 0)
+	restoreIncludeNSList.Title = titleStyle("Include Namespaces (space to toggle)")
+	restoreIncludeNSList.SetShowStatusBar(false)
+	restoreIncludeNSList.SetFilteringEnabled(false)
+	restoreIncludeNSList.SetShowHelp(false)
+	restoreIncludeNSList.SetSize(70, 20)
+
+	restoreExcludeNSList := list.New([]list.Item{}, delegate, 0, 0)
+	restoreExcludeNSList.Title = titleStyle("Exclude Namespaces (space to toggle)")
+	restoreExcludeNSList.SetShowStatusBar(false)
+	restoreExcludeNSList.SetFilteringEnabled(false)
+	restoreExcludeNSList.SetShowHelp(false)
+	restoreExcludeNSList.SetSize(70, 20)
+
 	return model{
-		step:            stepOperation,
-		operationList:   operationList,
-		contextList:     contextList,
-		namespaceList:   namespaceList,
-		backupList:      backupList,
-		backupNameInput: backupNameInput,
-		selectedNS:      []list.Item{},
+		step:                     stepOperation,
+		operationList:            operationList,
+		contextList:              contextList,
+		namespaceList:            namespaceList,
+		backupList:               backupList,
+		scheduleList:             scheduleList,
+		excludeNamespaceList:     excludeNamespaceList,
+		backupNameInput:          backupNameInput,
+		cronInput:                cronInput,
+		ttlInput:                 ttlInput,
+		scheduleNameInput:        scheduleNameInput,
+		progressSpinner:          progressSpinner,
+		progressViewport:         progressViewport,
+		backupTTLInput:           backupTTLInput,
+		backupSnapshotVolumes:    true,
+		backupSelectorInput:      backupSelectorInput,
+		selectedNS:               []list.Item{},
+		selectedExcludeNS:        []list.Item{},
+		namespaceMappingsInput:   namespaceMappingsInput,
+		restoreIncludeNSList:     restoreIncludeNSList,
+		restoreExcludeNSList:     restoreExcludeNSList,
+		selectedRestoreIncludeNS: []list.Item{},
+		selectedRestoreExcludeNS: []list.Item{},
+		restoreVolumes:           true,
 	}
 }
 
@@ -221,11 +580,60 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case "enter":
 			return m.handleEnter()
 		case "ctrl+c", "q":
-			logger.Println("Program exited by user")
-			return m, tea.Quit
+			if msg.String() == "ctrl+c" || !m.textInputFocused() {
+				logger.Println("Program exited by user")
+				return m, tea.Quit
+			}
 		case " ":
 			return m.handleSpace()
+		case "l":
+			if m.step == stepResult {
+				return m.handleViewLogs()
+			}
+		case "p":
+			if m.step == stepScheduleList {
+				return m.handleScheduleAction("pause")
+			}
+		case "d":
+			if m.step == stepScheduleList {
+				return m.handleScheduleAction("delete")
+			}
+		case "tab":
+			if m.step == stepBackupOptions {
+				m.backupOptionsFocus = backupOptionsField(cycleFocus(int(m.backupOptionsFocus), int(numBackupOptionsFields), 1))
+				return m.focusBackupOptionsField()
+			}
+			if m.step == stepRestoreOptions {
+				m.restoreOptionsFocus = restoreOptionsField(cycleFocus(int(m.restoreOptionsFocus), int(numRestoreOptionsFields), 1))
+				return m.focusRestoreOptionsField()
+			}
+		case "shift+tab":
+			if m.step == stepBackupOptions {
+				m.backupOptionsFocus = backupOptionsField(cycleFocus(int(m.backupOptionsFocus), int(numBackupOptionsFields), -1))
+				return m.focusBackupOptionsField()
+			}
+			if m.step == stepRestoreOptions {
+				m.restoreOptionsFocus = restoreOptionsField(cycleFocus(int(m.restoreOptionsFocus), int(numRestoreOptionsFields), -1))
+				return m.focusRestoreOptionsField()
+			}
 		}
+	case backupStatusMsg:
+		m.progressStatus = msg.status
+		m.progressLog = append(m.progressLog, formatProgressLine(msg.status))
+		m.progressViewport.SetContent(strings.Join(m.progressLog, "\n"))
+		m.progressViewport.GotoBottom()
+		return m, pollOperation(msg.operation, msg.name, pollInterval)
+	case backupCompleteMsg:
+		status := msg.status
+		m.resultOperation = msg.operation
+		m.resultName = msg.name
+		m.resultStatus = &status
+		m.step = stepResult
+		return m, nil
+	case backupFailedMsg:
+		m.err = fmt.Errorf("error polling %s %s: %w", msg.operation, msg.name, msg.err)
+		logger.Printf(errorMessageFormat, m.err)
+		return m, nil
 	}
 
 	var cmd tea.Cmd
@@ -240,6 +648,37 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.namespaceList, cmd = m.namespaceList.Update(msg)
 	case stepBackupName:
 		m.backupNameInput, cmd = m.backupNameInput.Update(msg)
+	case stepScheduleCron:
+		m.cronInput, cmd = m.cronInput.Update(msg)
+	case stepScheduleTTL:
+		m.ttlInput, cmd = m.ttlInput.Update(msg)
+	case stepScheduleName:
+		m.scheduleNameInput, cmd = m.scheduleNameInput.Update(msg)
+	case stepBackupOptions:
+		switch m.backupOptionsFocus {
+		case fieldTTL:
+			m.backupTTLInput, cmd = m.backupTTLInput.Update(msg)
+		case fieldSelector:
+			m.backupSelectorInput, cmd = m.backupSelectorInput.Update(msg)
+		case fieldExcludeNamespaces:
+			m.excludeNamespaceList, cmd = m.excludeNamespaceList.Update(msg)
+		}
+	case stepRestoreOptions:
+		switch m.restoreOptionsFocus {
+		case restoreFieldNamespaceMappings:
+			m.namespaceMappingsInput, cmd = m.namespaceMappingsInput.Update(msg)
+		case restoreFieldIncludeNamespaces:
+			m.restoreIncludeNSList, cmd = m.restoreIncludeNSList.Update(msg)
+		case restoreFieldExcludeNamespaces:
+			m.restoreExcludeNSList, cmd = m.restoreExcludeNSList.Update(msg)
+		}
+	case stepProgress:
+		var spinnerCmd, viewportCmd tea.Cmd
+		m.progressSpinner, spinnerCmd = m.progressSpinner.Update(msg)
+		m.progressViewport, viewportCmd = m.progressViewport.Update(msg)
+		cmd = tea.Batch(spinnerCmd, viewportCmd)
+	case stepScheduleList:
+		m.scheduleList, cmd = m.scheduleList.Update(msg)
 	}
 	return m, cmd
 }
@@ -256,6 +695,16 @@ func (m model) handleEnter() (tea.Model, tea.Cmd) {
 		return m.handleNamespaceEnter()
 	case stepBackupName:
 		return m.handleBackupNameEnter()
+	case stepScheduleCron:
+		return m.handleScheduleCronEnter()
+	case stepScheduleTTL:
+		return m.handleScheduleTTLEnter()
+	case stepScheduleName:
+		return m.handleScheduleNameEnter()
+	case stepBackupOptions:
+		return m.handleBackupOptionsEnter()
+	case stepRestoreOptions:
+		return m.handleRestoreOptionsEnter()
 	case stepExecute:
 		return m.handleExecuteEnter()
 	}
@@ -266,6 +715,30 @@ func (m model) handleSpace() (tea.Model, tea.Cmd) {
 	switch m.step {
 	case stepNamespace:
 		m.toggleSelection(&m.namespaceList, &m.selectedNS)
+	case stepScheduleCron:
+		var cmd tea.Cmd
+		m.cronInput, cmd = m.cronInput.Update(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+		return m, cmd
+	case stepBackupOptions:
+		switch m.backupOptionsFocus {
+		case fieldSnapshotVolumes:
+			m.backupSnapshotVolumes = !m.backupSnapshotVolumes
+		case fieldIncludeClusterResources:
+			m.backupIncludeClusterRes = nextTriState(m.backupIncludeClusterRes)
+		case fieldExcludeNamespaces:
+			m.toggleSelection(&m.excludeNamespaceList, &m.selectedExcludeNS)
+		}
+	case stepRestoreOptions:
+		switch m.restoreOptionsFocus {
+		case restoreFieldIncludeNamespaces:
+			m.toggleSelection(&m.restoreIncludeNSList, &m.selectedRestoreIncludeNS)
+		case restoreFieldExcludeNamespaces:
+			m.toggleSelection(&m.restoreExcludeNSList, &m.selectedRestoreExcludeNS)
+		case restoreFieldRestoreVolumes:
+			m.restoreVolumes = !m.restoreVolumes
+		case restoreFieldPreserveNodeports:
+			m.preserveNodeports = !m.preserveNodeports
+		}
 	}
 	return m, nil
 }
@@ -273,7 +746,8 @@ func (m model) handleSpace() (tea.Model, tea.Cmd) {
 func (m model) handleOperationEnter() (tea.Model, tea.Cmd) {
 	m.selectedOp = m.operationList.SelectedItem().(item)
 	logger.Printf("Selected operation: %s", m.selectedOp.Title())
-	if m.selectedOp.Title() == "Backup" {
+	switch m.selectedOp.Title() {
+	case "Backup", "Schedule":
 		m.step = stepContext
 		contextItems, err := fetchItems("kubectl config get-contexts -o name")
 		if err != nil {
@@ -282,7 +756,16 @@ func (m model) handleOperationEnter() (tea.Model, tea.Cmd) {
 			return m, tea.Quit
 		}
 		m.contextList.SetItems(contextItems)
-	} else {
+	case "Manage Schedules":
+		m.step = stepScheduleList
+		scheduleItems, err := fetchSchedules()
+		if err != nil {
+			m.err = fmt.Errorf("error fetching schedules: %w", err)
+			logger.Printf(errorMessageFormat, m.err)
+			return m, tea.Quit
+		}
+		m.scheduleList.SetItems(scheduleItems)
+	default:
 		m.step = stepBackupSelection
 		backupItems, err := fetchBackups()
 		if err != nil {
@@ -298,15 +781,17 @@ func (m model) handleOperationEnter() (tea.Model, tea.Cmd) {
 func (m model) handleBackupSelectionEnter() (tea.Model, tea.Cmd) {
 	m.selectedBackup = m.backupList.SelectedItem().(item)
 	logger.Printf("Selected backup: %s", m.selectedBackup.Title())
-	m.step = stepContext
-	contextItems, err := fetchItems("kubectl config get-contexts -o name")
+	m.step = stepRestoreOptions
+	m.restoreOptionsFocus = restoreFieldNamespaceMappings
+	nsItems, err := fetchBackupNamespaces(m.selectedBackup.Title())
 	if err != nil {
-		m.err = fmt.Errorf("error fetching contexts: %w", err)
+		m.err = fmt.Errorf("error fetching backup namespaces: %w", err)
 		logger.Printf(errorMessageFormat, m.err)
 		return m, tea.Quit
 	}
-	m.contextList.SetItems(contextItems)
-	return m, nil
+	m.restoreIncludeNSList.SetItems(nsItems)
+	m.restoreExcludeNSList.SetItems(nsItems)
+	return m.focusRestoreOptionsField()
 }
 
 func (m model) handleContextEnter() (tea.Model, tea.Cmd) {
@@ -314,18 +799,23 @@ func (m model) handleContextEnter() (tea.Model, tea.Cmd) {
 	logger.Printf("Selected context: %s", m.selectedCtx.Title())
 	m.step = stepNamespace
 	namespaceItems, err := fetchItems("kubectl get namespaces -o custom-columns=NAME:.metadata.name --no-headers")
-if err != nil {
+	if err != nil {
 		m.err = fmt.Errorf("error fetching namespaces: %w", err)
 		logger.Printf(errorMessageFormat, m.err)
 		return m, tea.Quit
 	}
 	m.namespaceList.SetItems(namespaceItems)
+	m.excludeNamespaceList.SetItems(namespaceItems)
 	return m, nil
 }
 
 func (m model) handleNamespaceEnter() (tea.Model, tea.Cmd) {
 	if len(m.selectedNS) > 0 {
 		logger.Printf("Selected namespaces: %v", m.selectedNS)
+		if m.selectedOp.Title() == "Schedule" {
+			m.step = stepScheduleCron
+			return m, m.cronInput.Focus()
+		}
 		m.step = stepBackupName
 		return m, m.backupNameInput.Focus()
 	} else {
@@ -338,51 +828,306 @@ func (m model) handleNamespaceEnter() (tea.Model, tea.Cmd) {
 func (m model) handleBackupNameEnter() (tea.Model, tea.Cmd) {
 	m.backupName = m.backupNameInput.Value()
 	logger.Printf("Entered backup name: %s", m.backupName)
+	m.step = stepBackupOptions
+	m.backupOptionsFocus = fieldTTL
+	return m.focusBackupOptionsField()
+}
+
+// backupOptionsForm lists the backup options fields in backupOptionsField
+// order, so tabForm can focus/blur whichever one currently has Tab focus.
+// Fields that aren't text inputs (toggles, the exclude-namespaces list)
+// don't need to react to focus changes.
+func (m *model) backupOptionsForm() tabForm {
+	return tabForm{fields: []focusableField{
+		fieldTTL:                     &m.backupTTLInput,
+		fieldSnapshotVolumes:         noopFocusField{},
+		fieldIncludeClusterResources: noopFocusField{},
+		fieldSelector:                &m.backupSelectorInput,
+		fieldExcludeNamespaces:       noopFocusField{},
+	}}
+}
+
+// focusBackupOptionsField focuses whichever field backupOptionsFocus
+// points at (if any) and blurs the rest, so Tab-cycling only routes
+// keystrokes to one field at a time.
+func (m model) focusBackupOptionsField() (model, tea.Cmd) {
+	cmd := m.backupOptionsForm().focusField(int(m.backupOptionsFocus))
+	return m, cmd
+}
+
+// textInputFocused reports whether any of the model's text inputs is
+// currently focused, so the global ctrl+c/q quit binding doesn't swallow
+// letters (like 'q') the user is trying to type into it.
+func (m model) textInputFocused() bool {
+	return m.backupNameInput.Focused() ||
+		m.cronInput.Focused() ||
+		m.ttlInput.Focused() ||
+		m.scheduleNameInput.Focused() ||
+		m.backupTTLInput.Focused() ||
+		m.backupSelectorInput.Focused() ||
+		m.namespaceMappingsInput.Focused()
+}
+
+// nextTriState advances a true/false/unset toggle: unset -> true -> false -> unset.
+func nextTriState(v *bool) *bool {
+	switch {
+	case v == nil:
+		t := true
+		return &t
+	case *v:
+		f := false
+		return &f
+	default:
+		return nil
+	}
+}
+
+func (m model) handleBackupOptionsEnter() (tea.Model, tea.Cmd) {
+	logger.Printf(
+		"Backup options: ttl=%q snapshot-volumes=%v include-cluster-resources=%v selector=%q exclude-namespaces=%d",
+		m.backupTTLInput.Value(), m.backupSnapshotVolumes, m.backupIncludeClusterRes, m.backupSelectorInput.Value(), len(m.selectedExcludeNS),
+	)
+	m.step = stepExecute
+	return m, nil
+}
+
+// buildBackupOptionArgs turns the backup options form into the extra
+// `velero backup create` flags it represents, omitting anything left unset.
+func (m model) buildBackupOptionArgs() []string {
+	var args []string
+
+	if ttl := strings.TrimSpace(m.backupTTLInput.Value()); ttl != "" {
+		args = append(args, fmt.Sprintf("--ttl %s", ttl))
+	}
+
+	args = append(args, fmt.Sprintf("--snapshot-volumes=%t", m.backupSnapshotVolumes))
+
+	if m.backupIncludeClusterRes != nil {
+		args = append(args, fmt.Sprintf("--include-cluster-resources=%t", *m.backupIncludeClusterRes))
+	}
+
+	if selector := strings.TrimSpace(m.backupSelectorInput.Value()); selector != "" {
+		args = append(args, fmt.Sprintf("--selector %s", shellQuote(selector)))
+	}
+
+	if len(m.selectedExcludeNS) > 0 {
+		excludeStrs := make([]string, 0, len(m.selectedExcludeNS))
+		for _, nsItem := range m.selectedExcludeNS {
+			if i, ok := nsItem.(item); ok {
+				excludeStrs = append(excludeStrs, i.Title())
+			}
+		}
+		args = append(args, fmt.Sprintf("--exclude-namespaces %s", strings.Join(excludeStrs, ",")))
+	}
+
+	return args
+}
+
+// restoreOptionsForm lists the restore options fields in restoreOptionsField
+// order, so tabForm can focus/blur whichever one currently has Tab focus,
+// mirroring backupOptionsForm.
+func (m *model) restoreOptionsForm() tabForm {
+	return tabForm{fields: []focusableField{
+		restoreFieldNamespaceMappings: &m.namespaceMappingsInput,
+		restoreFieldIncludeNamespaces: noopFocusField{},
+		restoreFieldExcludeNamespaces: noopFocusField{},
+		restoreFieldRestoreVolumes:    noopFocusField{},
+		restoreFieldPreserveNodeports: noopFocusField{},
+	}}
+}
+
+// focusRestoreOptionsField focuses whichever field restoreOptionsFocus
+// points at (if any) and blurs the rest, mirroring focusBackupOptionsField.
+func (m model) focusRestoreOptionsField() (model, tea.Cmd) {
+	cmd := m.restoreOptionsForm().focusField(int(m.restoreOptionsFocus))
+	return m, cmd
+}
+
+func (m model) handleRestoreOptionsEnter() (tea.Model, tea.Cmd) {
+	logger.Printf(
+		"Restore options: namespace-mappings=%q include-namespaces=%d exclude-namespaces=%d restore-volumes=%v preserve-nodeports=%v",
+		m.namespaceMappingsInput.Value(), len(m.selectedRestoreIncludeNS), len(m.selectedRestoreExcludeNS), m.restoreVolumes, m.preserveNodeports,
+	)
+	m.step = stepExecute
+	return m, nil
+}
+
+// buildRestoreOptionArgs turns the restore options form into the extra
+// `velero restore create` flags it represents, omitting anything left unset.
+func (m model) buildRestoreOptionArgs() []string {
+	var args []string
+
+	if mappings := strings.TrimSpace(m.namespaceMappingsInput.Value()); mappings != "" {
+		args = append(args, fmt.Sprintf("--namespace-mappings %s", shellQuote(mappings)))
+	}
+
+	if len(m.selectedRestoreIncludeNS) > 0 {
+		includeStrs := make([]string, 0, len(m.selectedRestoreIncludeNS))
+		for _, nsItem := range m.selectedRestoreIncludeNS {
+			if i, ok := nsItem.(item); ok {
+				includeStrs = append(includeStrs, i.Title())
+			}
+		}
+		args = append(args, fmt.Sprintf("--include-namespaces %s", strings.Join(includeStrs, ",")))
+	}
+
+	if len(m.selectedRestoreExcludeNS) > 0 {
+		excludeStrs := make([]string, 0, len(m.selectedRestoreExcludeNS))
+		for _, nsItem := range m.selectedRestoreExcludeNS {
+			if i, ok := nsItem.(item); ok {
+				excludeStrs = append(excludeStrs, i.Title())
+			}
+		}
+		args = append(args, fmt.Sprintf("--exclude-namespaces %s", strings.Join(excludeStrs, ",")))
+	}
+
+	args = append(args, fmt.Sprintf("--restore-volumes=%t", m.restoreVolumes))
+	args = append(args, fmt.Sprintf("--preserve-nodeports=%t", m.preserveNodeports))
+
+	return args
+}
+
+func (m model) handleScheduleCronEnter() (tea.Model, tea.Cmd) {
+	m.scheduleCron = m.cronInput.Value()
+	logger.Printf("Entered schedule cron: %s", m.scheduleCron)
+	m.step = stepScheduleTTL
+	return m, m.ttlInput.Focus()
+}
+
+func (m model) handleScheduleTTLEnter() (tea.Model, tea.Cmd) {
+	m.scheduleTTL = m.ttlInput.Value()
+	logger.Printf("Entered schedule TTL: %s", m.scheduleTTL)
+	m.step = stepScheduleName
+	return m, m.scheduleNameInput.Focus()
+}
+
+func (m model) handleScheduleNameEnter() (tea.Model, tea.Cmd) {
+	m.scheduleName = m.scheduleNameInput.Value()
+	logger.Printf("Entered schedule name: %s", m.scheduleName)
 	m.step = stepExecute
-	return m, tea.Quit
+	return m, nil
+}
+
+// handleScheduleAction runs a pause/unpause or delete against the currently
+// highlighted schedule in stepScheduleList, then refreshes the list.
+func (m model) handleScheduleAction(action string) (tea.Model, tea.Cmd) {
+	index := m.scheduleList.Index()
+	items := m.scheduleList.Items()
+	if index < 0 || index >= len(items) {
+		return m, nil
+	}
+	selected := items[index].(item)
+
+	var actionCmd string
+	switch action {
+	case "pause":
+		if strings.Contains(selected.description, "Paused") {
+			actionCmd = fmt.Sprintf("velero schedule unpause %s", selected.title)
+		} else {
+			actionCmd = fmt.Sprintf("velero schedule pause %s", selected.title)
+		}
+	case "delete":
+		actionCmd = fmt.Sprintf("velero schedule delete %s --confirm", selected.title)
+	}
+
+	if _, err := runShellCommand(actionCmd, true); err != nil {
+		m.err = fmt.Errorf("error running schedule action: %w", err)
+		logger.Printf(errorMessageFormat, m.err)
+		return m, nil
+	}
+
+	scheduleItems, err := fetchSchedules()
+	if err != nil {
+		m.err = fmt.Errorf("error refreshing schedules: %w", err)
+		logger.Printf(errorMessageFormat, m.err)
+		return m, nil
+	}
+	m.scheduleList.SetItems(scheduleItems)
+	return m, nil
 }
 
 func (m model) handleExecuteEnter() (tea.Model, tea.Cmd) {
-	if m.selectedOp.Title() == "Backup" {
+	if m.selectedOp.Title() == "Schedule" {
 		namespaceStrs := make([]string, 0, len(m.selectedNS))
 		for _, namespaceItem := range m.selectedNS {
 			if i, ok := namespaceItem.(item); ok {
 				namespaceStrs = append(namespaceStrs, i.Title())
 			}
 		}
-		backupCmd := fmt.Sprintf("velero backup create %s --include-namespaces %s --kubecontext %s", m.backupName, strings.Join(namespaceStrs, ","), m.selectedCtx.Title())
-		output, err := runShellCommand(backupCmd, true)
-		logger.Printf("Backup command output: %s", output)
+		scheduleCmd := fmt.Sprintf(
+			"velero schedule create %s --schedule=%s --include-namespaces %s --kubecontext %s",
+			shellQuote(m.scheduleName), shellQuote(m.scheduleCron), strings.Join(namespaceStrs, ","), m.selectedCtx.Title(),
+		)
+		if ttl := strings.TrimSpace(m.scheduleTTL); ttl != "" {
+			scheduleCmd += fmt.Sprintf(" --ttl %s", shellQuote(ttl))
+		}
+		output, err := runShellCommand(scheduleCmd, true)
+		logger.Printf("Schedule command output: %s", output)
 		if err != nil {
-			m.err = fmt.Errorf("error starting backup: %w", err)
+			m.err = fmt.Errorf("error creating schedule: %w", err)
 			logger.Printf("Error: %v\nOutput: %s", m.err, output)
 			return m, tea.Quit
 		}
-		// Add a loop to fetch and log the status until the backup is completed or failed
-		if err := waitForCompletion("backup", m.backupName); err != nil {
-			m.err = fmt.Errorf("error waiting for backup completion: %w", err)
-			logger.Printf(errorMessageFormat, m.err)
-			return m, tea.Quit
+		m.resultMessage = fmt.Sprintf("Schedule %q created with cron %q", m.scheduleName, m.scheduleCron)
+		m.step = stepResult
+		return m, nil
+	}
+
+	if m.selectedOp.Title() == "Backup" {
+		namespaceStrs := make([]string, 0, len(m.selectedNS))
+		for _, namespaceItem := range m.selectedNS {
+			if i, ok := namespaceItem.(item); ok {
+				namespaceStrs = append(namespaceStrs, i.Title())
+			}
 		}
-		logger.Println("Backup complete")
-	} else {
-		restoreCmd := fmt.Sprintf("velero restore create --from-backup %s", m.selectedBackup.Title())
-		output, err := runShellCommand(restoreCmd, true)
-		logger.Printf("Restore command output: %s", output)
+		backupCmd := fmt.Sprintf("velero backup create %s --include-namespaces %s --kubecontext %s", m.backupName, strings.Join(namespaceStrs, ","), m.selectedCtx.Title())
+		if extra := m.buildBackupOptionArgs(); len(extra) > 0 {
+			backupCmd = backupCmd + " " + strings.Join(extra, " ")
+		}
+		output, err := runShellCommand(backupCmd, true)
+		logger.Printf("Backup command output: %s", output)
 		if err != nil {
-			m.err = fmt.Errorf("error starting restore: %w", err)
+			m.err = fmt.Errorf("error starting backup: %w", err)
 			logger.Printf("Error: %v\nOutput: %s", m.err, output)
 			return m, tea.Quit
 		}
-		// Add a loop to fetch and log the status until the restore is completed or failed
-		if err := waitForCompletion("restore", m.selectedBackup.Title()); err != nil {
-			m.err = fmt.Errorf("error waiting for restore completion: %w", err)
-			logger.Printf(errorMessageFormat, m.err)
-			return m, tea.Quit
-		}
-		logger.Println("Restore complete")
+		m.progressOperation = "backup"
+		m.progressName = m.backupName
+		m.progressLog = nil
+		m.progressViewport.SetContent("")
+		m.step = stepProgress
+		return m, tea.Batch(m.progressSpinner.Tick, pollOperation("backup", m.backupName, pollInterval))
+	}
+
+	restoreCmd := fmt.Sprintf("velero restore create --from-backup %s", m.selectedBackup.Title())
+	if extra := m.buildRestoreOptionArgs(); len(extra) > 0 {
+		restoreCmd = restoreCmd + " " + strings.Join(extra, " ")
+	}
+	output, err := runShellCommand(restoreCmd, true)
+	logger.Printf("Restore command output: %s", output)
+	if err != nil {
+		m.err = fmt.Errorf("error starting restore: %w", err)
+		logger.Printf("Error: %v\nOutput: %s", m.err, output)
+		return m, tea.Quit
 	}
-	return m, tea.Quit
+	m.progressOperation = "restore"
+	m.progressName = m.selectedBackup.Title()
+	m.progressLog = nil
+	m.progressViewport.SetContent("")
+	m.step = stepProgress
+	return m, tea.Batch(m.progressSpinner.Tick, pollOperation("restore", m.selectedBackup.Title(), pollInterval))
+}
+
+func (m model) handleViewLogs() (tea.Model, tea.Cmd) {
+	logsCmd := fmt.Sprintf("velero %s logs %s", m.resultOperation, m.resultName)
+	output, err := runShellCommand(logsCmd, true)
+	if err != nil {
+		m.err = fmt.Errorf("error fetching logs: %w", err)
+		logger.Printf(errorMessageFormat, m.err)
+		return m, nil
+	}
+	m.resultLogs = output
+	return m, nil
 }
 
 func renderSelectedItems(selected []list.Item) string {
@@ -395,6 +1140,111 @@ func renderSelectedItems(selected []list.Item) string {
 	return builder.String()
 }
 
+// renderConfirmation renders the stepExecute confirmation panel shown before
+// the selected operation's command is actually run.
+func (m model) renderConfirmation() string {
+	switch m.selectedOp.Title() {
+	case "Schedule":
+		return fmt.Sprintf("Create schedule %q on %q?", m.scheduleName, m.scheduleCron)
+	case "Backup":
+		return fmt.Sprintf("Create backup %q?", m.backupName)
+	default:
+		restoreCmd := fmt.Sprintf("velero restore create --from-backup %s", m.selectedBackup.Title())
+		if extra := m.buildRestoreOptionArgs(); len(extra) > 0 {
+			restoreCmd = restoreCmd + " " + strings.Join(extra, " ")
+		}
+		return fmt.Sprintf("Run the following restore command?\n\n%s", restoreCmd)
+	}
+}
+
+// renderProgress renders the live status pane shown while a backup or
+// restore is running: a pinned header with phase/progress, and a scrolling
+// viewport of status snapshots below it.
+func (m model) renderProgress() string {
+	s := m.progressStatus.Status
+	header := fmt.Sprintf(
+		"%s %s %s\nPhase: %s\nItems backed up: %d/%d\nVolume Snapshots: %d/%d completed",
+		m.progressSpinner.View(), m.progressOperation, m.progressName,
+		s.Phase, s.Progress.ItemsBackedUp, s.Progress.TotalItems,
+		s.VolumeSnapshotsCompleted, s.VolumeSnapshotsAttempted,
+	)
+	return progressStyle.Render(header) + listStyle.Render(m.progressViewport.View())
+}
+
+// renderBackupOptions renders the configurable backup options form, marking
+// the field that currently has Tab focus.
+func (m model) renderBackupOptions() string {
+	triState := "unset"
+	if m.backupIncludeClusterRes != nil {
+		triState = fmt.Sprintf("%t", *m.backupIncludeClusterRes)
+	}
+
+	form := fmt.Sprintf(
+		"%sTTL: %s\n%sSnapshot Volumes: %t\n%sInclude Cluster Resources: %s\n%sSelector: %s\n%sExclude Namespaces:\n%s",
+		focusMarker(m.backupOptionsFocus == fieldTTL), m.backupTTLInput.View(),
+		focusMarker(m.backupOptionsFocus == fieldSnapshotVolumes), m.backupSnapshotVolumes,
+		focusMarker(m.backupOptionsFocus == fieldIncludeClusterResources), triState,
+		focusMarker(m.backupOptionsFocus == fieldSelector), m.backupSelectorInput.View(),
+		focusMarker(m.backupOptionsFocus == fieldExcludeNamespaces), m.excludeNamespaceList.View(),
+	)
+
+	selected := selectedListStyle.Render(fmt.Sprintf("Excluded Namespaces:\n%s", renderSelectedItems(m.selectedExcludeNS)))
+	return lipgloss.JoinHorizontal(lipgloss.Top, listStyle.Render(form), selected)
+}
+
+// renderRestoreOptions renders the restore customization form, marking the
+// field that currently has Tab focus.
+func (m model) renderRestoreOptions() string {
+	form := fmt.Sprintf(
+		"%sNamespace Mappings: %s\n%sInclude Namespaces:\n%s\n%sExclude Namespaces:\n%s\n%sRestore Volumes: %t\n%sPreserve Nodeports: %t",
+		focusMarker(m.restoreOptionsFocus == restoreFieldNamespaceMappings), m.namespaceMappingsInput.View(),
+		focusMarker(m.restoreOptionsFocus == restoreFieldIncludeNamespaces), m.restoreIncludeNSList.View(),
+		focusMarker(m.restoreOptionsFocus == restoreFieldExcludeNamespaces), m.restoreExcludeNSList.View(),
+		focusMarker(m.restoreOptionsFocus == restoreFieldRestoreVolumes), m.restoreVolumes,
+		focusMarker(m.restoreOptionsFocus == restoreFieldPreserveNodeports), m.preserveNodeports,
+	)
+
+	selected := selectedListStyle.Render(fmt.Sprintf(
+		"Included Namespaces:\n%s\n\nExcluded Namespaces:\n%s",
+		renderSelectedItems(m.selectedRestoreIncludeNS), renderSelectedItems(m.selectedRestoreExcludeNS),
+	))
+	return lipgloss.JoinHorizontal(lipgloss.Top, listStyle.Render(form), selected)
+}
+
+// renderResult renders the terminal summary panel shown once a backup or
+// restore reaches a finished phase: overall status plus warning/error and
+// volume snapshot counters.
+func (m model) renderResult() string {
+	if m.resultStatus == nil {
+		return resultStyle.Render(m.resultMessage)
+	}
+
+	s := m.resultStatus.Status
+
+	var phaseView string
+	switch s.Phase {
+	case "Completed":
+		phaseView = successStyle(s.Phase)
+	case "PartiallyFailed":
+		phaseView = warningStyle(s.Phase)
+	case "Failed":
+		phaseView = errorStyle(s.Phase)
+	default:
+		phaseView = s.Phase
+	}
+
+	summary := fmt.Sprintf(
+		"Operation: %s\nName: %s\nPhase: %s\nWarnings: %d\nErrors: %d\nVolume Snapshots: %d/%d completed",
+		m.resultOperation, m.resultName, phaseView, s.Warnings, s.Errors, s.VolumeSnapshotsCompleted, s.VolumeSnapshotsAttempted,
+	)
+
+	panel := resultStyle.Render(summary)
+	if m.resultLogs != "" {
+		panel = lipgloss.JoinVertical(lipgloss.Left, panel, listStyle.Render(m.resultLogs))
+	}
+	return panel
+}
+
 func (m model) View() string {
 	var errView string
 	if m.err != nil {
@@ -419,6 +1269,29 @@ func (m model) View() string {
 	case stepBackupName:
 		backupNameView := listStyle.Render(m.backupNameInput.View())
 		return backupNameView + fmt.Sprintf(viewFormat, helpStyle(helpMessage), errView)
+	case stepScheduleCron:
+		cronView := listStyle.Render(m.cronInput.View())
+		return cronView + fmt.Sprintf(viewFormat, helpStyle(helpMessage), errView)
+	case stepScheduleTTL:
+		ttlView := listStyle.Render(m.ttlInput.View())
+		return ttlView + fmt.Sprintf(viewFormat, helpStyle(helpMessage), errView)
+	case stepScheduleName:
+		scheduleNameView := listStyle.Render(m.scheduleNameInput.View())
+		return scheduleNameView + fmt.Sprintf(viewFormat, helpStyle(helpMessage), errView)
+	case stepScheduleList:
+		scheduleView := listStyle.Render(m.scheduleList.View())
+		return scheduleView + fmt.Sprintf(viewFormat, helpStyle(scheduleListHelp), errView)
+	case stepBackupOptions:
+		return m.renderBackupOptions() + fmt.Sprintf(viewFormat, helpStyle(backupOptionsHelp), errView)
+	case stepRestoreOptions:
+		return m.renderRestoreOptions() + fmt.Sprintf(viewFormat, helpStyle(restoreOptionsHelp), errView)
+	case stepExecute:
+		executeView := resultStyle.Render(m.renderConfirmation())
+		return executeView + fmt.Sprintf(viewFormat, helpStyle(helpMessage), errView)
+	case stepProgress:
+		return m.renderProgress() + fmt.Sprintf(viewFormat, helpStyle(progressHelpMessage), errView)
+	case stepResult:
+		return m.renderResult() + fmt.Sprintf(viewFormat, helpStyle(resultHelpMessage), errView)
 	}
 	return ""
 }